@@ -0,0 +1,125 @@
+/*
+Этот файл содержит HTTP шлюз на grpc-gateway, который транслирует REST JSON запросы в вызовы
+gRPC сервера из cmd/grpc_server. Маршруты генерируются из аннотаций google.api.http в note.proto,
+так что NoteInfo существует только в одном месте, а не дублируется между proto и ручными chi хендлерами.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/auth"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/middleware"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository/factory"
+	desc "github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/note_v1"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/observability"
+)
+
+const (
+	httpAddr   = "localhost:8081"
+	grpcTarget = "localhost:50051"
+
+	// serviceName — имя сервиса, под которым логи, метрики и трейсы этого процесса видны
+	// во внешних системах наблюдаемости
+	serviceName = "gateway"
+)
+
+// LoginRequest содержит логин и пароль для получения JWT
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LoginResponse содержит выданный токен для заголовка "Authorization: Bearer <token>"
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+// newLoginHandler отдает /login: эндпоинт аутентификации не описан в note.proto (это не операция
+// над заметками), поэтому он не может быть сгенерирован grpc-gateway и обслуживается вручную.
+// grpc-gateway по умолчанию перекладывает заголовок Authorization в исходящие метаданные gRPC,
+// так что полученный здесь токен без дополнительной настройки принимается AuthUnaryInterceptor.
+func newLoginHandler(authService *auth.Service) middleware.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		req := &LoginRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return apperror.BadRequest(err.Error())
+		}
+
+		token, err := authService.Login(r.Context(), req.Username, req.Password)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err = json.NewEncoder(w).Encode(LoginResponse{Token: token}); err != nil {
+			return apperror.SystemError(err)
+		}
+
+		return nil
+	}
+}
+
+// newSwaggerHandler отдает сгенерированный protoc-gen-openapiv2 swagger.json по /swagger.json
+func newSwaggerHandler() middleware.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) error {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(desc.SwaggerJSON)
+		return err
+	}
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obs, err := observability.Setup(ctx, observability.ConfigFromEnv(serviceName))
+	if err != nil {
+		log.Fatalf("failed to init observability: %v", err)
+	}
+	defer obs.Shutdown(ctx)
+
+	users, err := factory.NewUserRepository()
+	if err != nil {
+		log.Fatalf("failed to init user repository: %v", err)
+	}
+
+	tokens, err := factory.NewTokenManager()
+	if err != nil {
+		log.Fatalf("failed to init token manager: %v", err)
+	}
+
+	authService := auth.NewService(users, tokens)
+
+	gwMux := runtime.NewServeMux()
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	if err = desc.RegisterNoteV1HandlerFromEndpoint(ctx, gwMux, grpcTarget, opts); err != nil {
+		log.Fatalf("failed to register gateway handler: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", middleware.Recover(newLoginHandler(authService)))
+	mux.HandleFunc("/swagger.json", middleware.Recover(newSwaggerHandler()))
+	mux.Handle("/", gwMux)
+
+	handler := middleware.RequestLogging(obs.Logger, obs.Metrics)(otelhttp.NewHandler(mux, serviceName))
+
+	log.Printf("gateway listening at %s, proxying to grpc server at %s", httpAddr, grpcTarget)
+	if err = http.ListenAndServe(httpAddr, handler); err != nil {
+		log.Fatal(err)
+	}
+}
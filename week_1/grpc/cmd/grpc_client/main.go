@@ -1,36 +1,170 @@
 package main
 
 import (
+	"bytes"
 	"context"
-	desc "github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/note_v1"
-	"github.com/fatih/color"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"log"
-	"time"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/client"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/logger"
+	desc "github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/note_v1"
+	"github.com/brianvoe/gofakeit"
 )
 
 const (
-	address = "localhost:50051"
-	noteID  = 1
+	address  = "localhost:50051"
+	loginURL = "http://localhost:8081/login"
 )
 
 func main() {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	log, err := logger.New("grpc_client", "")
+	if err != nil {
+		panic(err)
+	}
+	defer log.Sync()
+
+	c, err := client.NewNoteClient(address, client.DefaultConfig(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("did not connect", zap.Error(err))
+	}
+	defer c.Close()
+
+	// Логинимся через gateway (он выдает токены, так как RSA-ключ принят из
+	// JWT_PRIVATE_KEY_PATH и совпадает для обоих процессов) и прикладываем токен к каждому
+	// вызову, чтобы заметки создавались с непустым OwnerId.
+	ctx := withAuth(context.Background(), login(log))
+
+	id := createNote(ctx, log, c)
+	getNote(ctx, log, c, id)
+	updateNote(ctx, log, c, id)
+	getNote(ctx, log, c, id)
+	listNotes(ctx, log, c)
+	deleteNote(ctx, log, c, id)
+}
+
+// login получает JWT у gateway по демо-учетке admin/admin123 через client.HTTPClient, так что
+// временная недоступность gateway (он мог еще не успеть обработать предыдущий запрос) переживается
+// повторами и circuit breaker'ом так же, как у NoteClient
+func login(log *zap.Logger) string {
+	body, err := json.Marshal(map[string]string{"username": "admin", "password": "admin123"})
+	if err != nil {
+		log.Fatal("could not marshal login request", zap.Error(err))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, loginURL, bytes.NewReader(body))
+	if err != nil {
+		log.Fatal("could not build login request", zap.Error(err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := client.NewHTTPClient(nil, client.DefaultConfig())
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		log.Fatal("could not login", zap.Error(err))
+	}
+	defer resp.Body.Close()
+
+	var out struct {
+		Token string `json:"token"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		log.Fatal("could not decode login response", zap.Error(err))
+	}
+
+	return out.Token
+}
+
+// withAuth кладет токен в исходящие метаданные gRPC-запроса в том виде ("authorization":
+// "Bearer <token>"), в котором его читает middleware.AuthUnaryInterceptor на сервере
+func withAuth(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func createNote(ctx context.Context, log *zap.Logger, c *client.NoteClient) string {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	r, err := c.Create(ctx, &desc.CreateRequest{
+		Info: &desc.NoteInfo{
+			Title:    gofakeit.BeerName(),
+			Context:  gofakeit.BeerName(),
+			Author:   gofakeit.Name(),
+			IsPublic: gofakeit.Bool(),
+		},
+	})
+	if err != nil {
+		log.Fatal("could not create note", zap.Error(err))
+	}
+	log.Info("note created", zap.String("id", r.GetId()))
+
+	return r.GetId()
+}
+
+func getNote(ctx context.Context, log *zap.Logger, c *client.NoteClient, id string) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	r, err := c.Get(ctx, &desc.GetRequest{Id: id})
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		log.Fatal("could not get note", zap.Error(err))
 	}
-	defer conn.Close()
+	log.Info("note info", zap.String("note", r.GetNote().String()))
+}
+
+func updateNote(ctx context.Context, log *zap.Logger, c *client.NoteClient, id string) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
 
-	c := desc.NewNoteV1Client(conn)
+	_, err := c.Update(ctx, &desc.UpdateRequest{
+		Id: id,
+		Info: &desc.UpdateNoteInfo{
+			Title: wrapperspb.String(gofakeit.BeerName()),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		log.Fatal("could not update note", zap.Error(err))
+	}
+	log.Info("note updated")
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+func deleteNote(ctx context.Context, log *zap.Logger, c *client.NoteClient, id string) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 
-	r, err := c.Get(ctx, &desc.GetRequest{Id: noteID})
+	if _, err := c.Delete(ctx, &desc.DeleteRequest{Id: id}); err != nil {
+		log.Fatal("could not delete note", zap.Error(err))
+	}
+	log.Info("note deleted")
+}
+
+func listNotes(ctx context.Context, log *zap.Logger, c *client.NoteClient) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	stream, err := c.List(ctx, &desc.ListRequest{PageToken: "", PageSize: 10})
 	if err != nil {
-		log.Fatalf("could not get note: %v", err)
+		log.Fatal("could not list notes", zap.Error(err))
 	}
-	log.Printf(color.GreenString("Note info:\n"), color.GreenString("%+v", r.GetNote()))
 
+	for {
+		note, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal("error receiving note", zap.Error(err))
+		}
+		log.Info("note from list", zap.String("note", note.String()))
+	}
 }
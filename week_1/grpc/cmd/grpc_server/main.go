@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/auth"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/middleware"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository/factory"
+	desc "github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/note_v1"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/observability"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// serviceName — имя сервиса, под которым логи, метрики и трейсы этого процесса видны
+// во внешних системах наблюдаемости
+const serviceName = "grpc_server"
+
+const grpcPort = 50051
+
+// defaultPageSize используется, если клиент не указал page_size в ListRequest
+const defaultPageSize = 10
+
+type server struct {
+	desc.UnimplementedNoteV1Server
+	repo repository.NoteRepository
+}
+
+// newServer создает реализацию NoteV1Server поверх переданного репозитория
+func newServer(repo repository.NoteRepository) *server {
+	return &server{repo: repo}
+}
+
+func (s *server) Create(ctx context.Context, req *desc.CreateRequest) (*desc.CreateResponse, error) {
+	ownerID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, apperror.Unauthorized("authentication required to create a note")
+	}
+
+	id, err := s.repo.Create(ctx, ownerID, model.NoteInfo{
+		Title:    req.GetInfo().GetTitle(),
+		Context:  req.GetInfo().GetContext(),
+		Author:   req.GetInfo().GetAuthor(),
+		IsPublic: req.GetInfo().GetIsPublic(),
+	})
+	if err != nil {
+		return nil, apperror.SystemError(err)
+	}
+
+	return &desc.CreateResponse{Id: id}, nil
+}
+
+func (s *server) Get(ctx context.Context, req *desc.GetRequest) (*desc.GetResponse, error) {
+	note, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, noteAppErr(err)
+	}
+
+	if !note.Info.IsPublic {
+		userID, ok := auth.UserIDFromContext(ctx)
+		if !ok || note.OwnerID != userID {
+			return nil, apperror.Forbidden("note belongs to another user")
+		}
+	}
+
+	return &desc.GetResponse{Note: noteToProto(note)}, nil
+}
+
+func (s *server) Update(ctx context.Context, req *desc.UpdateRequest) (*desc.UpdateResponse, error) {
+	if err := s.checkOwnership(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	patch := model.UpdatePatch{
+		Info: model.NoteInfo{
+			Title:    req.GetInfo().GetTitle().GetValue(),
+			Context:  req.GetInfo().GetContext().GetValue(),
+			Author:   req.GetInfo().GetAuthor().GetValue(),
+			IsPublic: req.GetInfo().GetIsPublic().GetValue(),
+		},
+		Mask: req.GetUpdateMask().GetPaths(),
+	}
+
+	if err := s.repo.Update(ctx, req.GetId(), patch); err != nil {
+		return nil, noteAppErr(err)
+	}
+
+	return &desc.UpdateResponse{}, nil
+}
+
+func (s *server) Delete(ctx context.Context, req *desc.DeleteRequest) (*desc.DeleteResponse, error) {
+	if err := s.checkOwnership(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, noteAppErr(err)
+	}
+
+	return &desc.DeleteResponse{}, nil
+}
+
+// checkOwnership возвращает *apperror.AppError, если запрос анонимный или заметка id не
+// принадлежит пользователю из ctx. В отличие от Get, для Update/Delete IsPublic не дает
+// права на изменение чужой заметки.
+func (s *server) checkOwnership(ctx context.Context, id string) error {
+	note, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return noteAppErr(err)
+	}
+
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return apperror.Unauthorized("authentication required")
+	}
+	if note.OwnerID != userID {
+		return apperror.Forbidden("note belongs to another user")
+	}
+
+	return nil
+}
+
+// List использует status.Error напрямую, так как UnaryServerErrorInterceptor не применяется
+// к серверным стримам. Как и Get, отдает приватные заметки только их владельцу — видимость
+// отдается в model.Filter, чтобы репозиторий применил ее прямо в запросе к хранилищу и
+// пагинация считалась по уже отфильтрованному набору.
+func (s *server) List(req *desc.ListRequest, stream desc.NoteV1_ListServer) error {
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	viewerID, _ := auth.UserIDFromContext(stream.Context())
+
+	notes, err := s.repo.List(stream.Context(), model.Filter{ViewerID: viewerID}, model.Page{
+		Token: req.GetPageToken(),
+		Size:  pageSize,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for _, note := range notes {
+		if err = stream.Send(noteToProto(note)); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// noteAppErr оборачивает ошибку репозитория в *apperror.AppError для UnaryServerErrorInterceptor
+func noteAppErr(err error) *apperror.AppError {
+	if errors.Is(err, repository.ErrNoteNotFound) {
+		return apperror.NotFound(err.Error())
+	}
+	return apperror.SystemError(err)
+}
+
+func noteToProto(note *model.Note) *desc.Note {
+	return &desc.Note{
+		Id:      note.ID,
+		OwnerId: note.OwnerID,
+		Info: &desc.NoteInfo{
+			Title:    note.Info.Title,
+			Context:  note.Info.Context,
+			Author:   note.Info.Author,
+			IsPublic: note.Info.IsPublic,
+		},
+		CreatedAt: timestamppb.New(note.CreatedAt),
+		UpdatedAt: timestamppb.New(note.UpdatedAt),
+	}
+}
+
+func main() {
+	ctx := context.Background()
+
+	obs, err := observability.Setup(ctx, observability.ConfigFromEnv(serviceName))
+	if err != nil {
+		log.Fatalf("failed to init observability: %v", err)
+	}
+	defer obs.Shutdown(ctx)
+
+	repo, err := factory.New(ctx)
+	if err != nil {
+		log.Fatalf("failed to init repository: %v", err)
+	}
+
+	tokens, err := factory.NewTokenManager()
+	if err != nil {
+		log.Fatalf("failed to init token manager: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			middleware.UnaryServerLoggingInterceptor(obs.Logger, obs.Metrics),
+			middleware.UnaryServerErrorInterceptor(),
+			middleware.AuthUnaryInterceptor(tokens),
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamServerLoggingInterceptor(obs.Logger, obs.Metrics),
+			middleware.AuthStreamInterceptor(tokens),
+		),
+	)
+	desc.RegisterNoteV1Server(s, newServer(repo))
+
+	log.Printf("grpc server listening at %v", lis.Addr())
+	if err = s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package apperror
+
+import "encoding/json"
+
+// Коды ошибок, понятные обоим транспортам (HTTP и gRPC): middleware.Recover и
+// middleware.UnaryServerErrorInterceptor транслируют их в конкретные статусы каждого протокола.
+const (
+	CodeBadRequest   = "BR-000"
+	CodeNotFound     = "NF-000"
+	CodeForbidden    = "FB-000"
+	CodeUnauthorized = "UA-000"
+	CodeSystem       = "SE-000"
+)
+
+// AppError — единый тип ошибки, который возвращают хендлеры HTTP и gRPC, вместо того чтобы
+// каждый транспорт сам решал, как отдать сообщение об ошибке клиенту.
+type AppError struct {
+	Err              error  `json:"-"`
+	Message          string `json:"message"`
+	DeveloperMessage string `json:"developer_message"`
+	Code             string `json:"code"`
+}
+
+// NewAppError создает ошибку с произвольным кодом
+func NewAppError(err error, message, developerMessage, code string) *AppError {
+	return &AppError{
+		Err:              err,
+		Message:          message,
+		DeveloperMessage: developerMessage,
+		Code:             code,
+	}
+}
+
+// BadRequest создает ошибку некорректного запроса клиента
+func BadRequest(developerMessage string) *AppError {
+	return NewAppError(nil, "некорректный запрос", developerMessage, CodeBadRequest)
+}
+
+// NotFound создает ошибку отсутствия запрошенного ресурса
+func NotFound(developerMessage string) *AppError {
+	return NewAppError(nil, "ресурс не найден", developerMessage, CodeNotFound)
+}
+
+// Forbidden создает ошибку отсутствия прав на запрошенное действие
+func Forbidden(developerMessage string) *AppError {
+	return NewAppError(nil, "доступ запрещен", developerMessage, CodeForbidden)
+}
+
+// Unauthorized создает ошибку отсутствия или недействительности учетных данных запроса
+func Unauthorized(developerMessage string) *AppError {
+	return NewAppError(nil, "требуется аутентификация", developerMessage, CodeUnauthorized)
+}
+
+// SystemError оборачивает внутреннюю ошибку, не предназначенную для показа клиенту напрямую
+func SystemError(err error) *AppError {
+	return NewAppError(err, "внутренняя ошибка сервера", err.Error(), CodeSystem)
+}
+
+func (e *AppError) Error() string {
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// Marshal сериализует ошибку в стабильное JSON-тело {code, message, developer_message}
+func (e *AppError) Marshal() []byte {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return []byte(`{"code":"` + CodeSystem + `","message":"internal error","developer_message":"failed to marshal AppError"}`)
+	}
+	return data
+}
@@ -0,0 +1,19 @@
+package auth
+
+import "context"
+
+type contextKey struct{}
+
+var userIDKey = contextKey{}
+
+// ContextWithUserID кладет ID аутентифицированного пользователя в контекст запроса
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext возвращает ID пользователя, положенный туда middleware аутентификации.
+// Второе значение — false, если запрос анонимный.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDKey).(string)
+	return userID, ok
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken возвращается Parse, если токен не прошел проверку подписи, истек
+// или не содержит ожидаемых claims
+var ErrInvalidToken = errors.New("invalid token")
+
+// rsaKeyBits — размер ключа, которым TokenManager подписывает токены
+const rsaKeyBits = 2048
+
+// claims — набор claims, зашиваемых в выданный токен
+type claims struct {
+	UserID string `json:"user_id"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager выпускает и проверяет JWT, подписанные RSA-ключом. Ключ либо генерируется
+// NewTokenManager и живет, пока жив процесс (токены, выданные до перезапуска сервера, после
+// него становятся недействительны), либо загружается из PEM через NewTokenManagerFromPEM.
+type TokenManager struct {
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+}
+
+// NewTokenManager генерирует новую пару RSA-ключей и возвращает TokenManager поверх нее. Так как
+// ключ не сохраняется, токены, выданные одним процессом, не проходят проверку в другом —
+// для связки gateway + grpc_server используйте NewTokenManagerFromPEM с общим ключом.
+func NewTokenManager() (*TokenManager, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("generate rsa key: %w", err)
+	}
+
+	return newTokenManager(privateKey), nil
+}
+
+// NewTokenManagerFromPEM создает TokenManager поверх RSA-ключа, закодированного в формате PEM
+// (PKCS1 или PKCS8), что позволяет нескольким процессам (gateway и grpc_server) разделять
+// один и тот же ключ и проверять токены друг друга
+func NewTokenManagerFromPEM(pemBytes []byte) (*TokenManager, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("invalid PEM: no block found")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return newTokenManager(privateKey), nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse rsa private key: %w", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("PEM key is not an RSA private key")
+	}
+
+	return newTokenManager(privateKey), nil
+}
+
+func newTokenManager(privateKey *rsa.PrivateKey) *TokenManager {
+	return &TokenManager{
+		privateKey: privateKey,
+		publicKey:  &privateKey.PublicKey,
+	}
+}
+
+// Issue выпускает токен для userID со сроком действия ttl
+func (m *TokenManager) Issue(userID string, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	})
+
+	return token.SignedString(m.privateKey)
+}
+
+// Parse проверяет подпись и срок действия токена и возвращает ID пользователя, для которого
+// он был выпущен
+func (m *TokenManager) Parse(tokenString string) (string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &claims{}, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("%w: unexpected signing method %v", ErrInvalidToken, token.Header["alg"])
+		}
+		return m.publicKey, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	c, ok := token.Claims.(*claims)
+	if !ok || !token.Valid || c.UserID == "" {
+		return "", ErrInvalidToken
+	}
+
+	return c.UserID, nil
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+)
+
+// tokenTTL — срок жизни токена, выдаваемого Login
+const tokenTTL = 24 * time.Hour
+
+// Service выдает токены доступа, проверяя логин и пароль против users
+type Service struct {
+	users  repository.UserRepository
+	tokens *TokenManager
+}
+
+// NewService создает auth.Service поверх репозитория пользователей и менеджера токенов
+func NewService(users repository.UserRepository, tokens *TokenManager) *Service {
+	return &Service{users: users, tokens: tokens}
+}
+
+// Login проверяет пару логин/пароль и возвращает подписанный JWT для найденного пользователя
+func (s *Service) Login(ctx context.Context, username, password string) (string, error) {
+	user, err := s.users.GetByUsername(ctx, username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return "", apperror.Unauthorized("invalid username or password")
+	}
+	if err != nil {
+		return "", apperror.SystemError(err)
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", apperror.Unauthorized("invalid username or password")
+	}
+
+	token, err := s.tokens.Issue(user.ID, tokenTTL)
+	if err != nil {
+		return "", apperror.SystemError(err)
+	}
+
+	return token, nil
+}
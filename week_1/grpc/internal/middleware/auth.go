@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/auth"
+)
+
+const bearerPrefix = "Bearer "
+
+// AuthUnaryInterceptor достает токен из метаданных
+// "authorization" и кладет ID пользователя в контекст запроса. Должен подключаться вместе
+// с UnaryServerErrorInterceptor, которому отдает *apperror.AppError при невалидном токене.
+func AuthUnaryInterceptor(tokens *auth.TokenManager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		token, ok := strings.CutPrefix(values[0], bearerPrefix)
+		if !ok {
+			return nil, apperror.Unauthorized("authorization metadata must use the Bearer scheme")
+		}
+
+		userID, err := tokens.Parse(token)
+		if err != nil {
+			return nil, apperror.Unauthorized(err.Error())
+		}
+
+		return handler(auth.ContextWithUserID(ctx, userID), req)
+	}
+}
+
+// AuthStreamInterceptor — аналог AuthUnaryInterceptor для серверных стримов (List): достает
+// токен из метаданных "authorization" и подменяет Context() у grpc.ServerStream, чтобы хендлер
+// видел ID пользователя через auth.UserIDFromContext. Ошибки отдаются через status.Error
+// напрямую, как и остальные ошибки серверных стримов — UnaryServerErrorInterceptor к ним
+// не применяется.
+func AuthStreamInterceptor(tokens *auth.TokenManager) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return handler(srv, ss)
+		}
+
+		token, ok := strings.CutPrefix(values[0], bearerPrefix)
+		if !ok {
+			return status.Error(codes.Unauthenticated, "authorization metadata must use the Bearer scheme")
+		}
+
+		userID, err := tokens.Parse(token)
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &userIDServerStream{ServerStream: ss, ctx: auth.ContextWithUserID(ctx, userID)})
+	}
+}
+
+// userIDServerStream подменяет Context() у grpc.ServerStream, чтобы обработчик видел ID
+// пользователя, положенный AuthStreamInterceptor
+type userIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *userIDServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/logger"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/metrics"
+)
+
+// UnaryServerErrorInterceptor конвертирует *apperror.AppError, возвращенный унарным хендлером,
+// в status.Error с соответствующим codes.Code и с apperror.DeveloperMessage, приложенным
+// через errdetails.ErrorInfo
+func UnaryServerErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		var appErr *apperror.AppError
+		if !errors.As(err, &appErr) {
+			return resp, err
+		}
+
+		st, detailErr := status.New(grpcCodeFromAppError(appErr.Code), appErr.Message).WithDetails(&errdetails.ErrorInfo{
+			Reason: appErr.Code,
+			Domain: info.FullMethod,
+			Metadata: map[string]string{
+				"developer_message": appErr.DeveloperMessage,
+			},
+		})
+		if detailErr != nil {
+			return resp, status.Error(grpcCodeFromAppError(appErr.Code), appErr.Message)
+		}
+
+		return resp, st.Err()
+	}
+}
+
+// UnaryServerLoggingInterceptor логирует каждый unary вызов в JSON (метод, код, длительность,
+// размер payload, request ID) и записывает его в metrics. Request ID генерируется здесь и
+// кладется в контекст, поэтому должен подключаться раньше UnaryServerErrorInterceptor, чтобы
+// видеть уже переведенный в status.Code финальный результат вызова.
+func UnaryServerLoggingInterceptor(log *zap.Logger, m *metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := logger.NewRequestID()
+		ctx = logger.ContextWithRequestID(ctx, requestID)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(start)
+
+		code := status.Code(err)
+		m.Observe(info.FullMethod, code.String(), duration, messageSize(req), messageSize(resp))
+
+		log.Info("grpc request",
+			zap.String("request_id", requestID),
+			zap.String("method", info.FullMethod),
+			zap.String("code", code.String()),
+			zap.Duration("duration", duration),
+		)
+
+		return resp, err
+	}
+}
+
+// StreamServerLoggingInterceptor — аналог UnaryServerLoggingInterceptor для серверных стримов
+// (List). Размер payload не отслеживается, так как сообщений может быть произвольно много.
+func StreamServerLoggingInterceptor(log *zap.Logger, m *metrics.Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := logger.NewRequestID()
+		ctx := logger.ContextWithRequestID(ss.Context(), requestID)
+
+		start := time.Now()
+		err := handler(srv, &requestIDServerStream{ServerStream: ss, ctx: ctx})
+		duration := time.Since(start)
+
+		code := status.Code(err)
+		m.Observe(info.FullMethod, code.String(), duration, 0, 0)
+
+		log.Info("grpc stream",
+			zap.String("request_id", requestID),
+			zap.String("method", info.FullMethod),
+			zap.String("code", code.String()),
+			zap.Duration("duration", duration),
+		)
+
+		return err
+	}
+}
+
+// requestIDServerStream подменяет Context() у grpc.ServerStream, чтобы обработчик видел
+// request ID, положенный StreamServerLoggingInterceptor
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// messageSize возвращает размер сериализованного protobuf сообщения m, либо 0, если m не proto.Message
+func messageSize(m any) int {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return 0
+	}
+	return proto.Size(msg)
+}
+
+func grpcCodeFromAppError(code string) codes.Code {
+	switch code {
+	case apperror.CodeBadRequest:
+		return codes.InvalidArgument
+	case apperror.CodeNotFound:
+		return codes.NotFound
+	case apperror.CodeForbidden:
+		return codes.PermissionDenied
+	case apperror.CodeUnauthorized:
+		return codes.Unauthenticated
+	default:
+		return codes.Internal
+	}
+}
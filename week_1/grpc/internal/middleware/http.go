@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/apperror"
+)
+
+// HandlerFunc — вариант http.HandlerFunc, которому позволено вернуть ошибку вместо того,
+// чтобы самостоятельно писать её в ResponseWriter
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Recover оборачивает HandlerFunc в http.HandlerFunc: восстанавливает хендлер после паники
+// и конвертирует возвращенную ошибку в HTTP статус + JSON тело apperror.AppError
+func Recover(next HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				writeAppError(w, apperror.SystemError(fmt.Errorf("panic: %v", rec)))
+			}
+		}()
+
+		if err := next(w, r); err != nil {
+			writeAppError(w, err)
+		}
+	}
+}
+
+func writeAppError(w http.ResponseWriter, err error) {
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		appErr = apperror.SystemError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(appErr.Code))
+	_, _ = w.Write(appErr.Marshal())
+}
+
+func httpStatusFromCode(code string) int {
+	switch code {
+	case apperror.CodeBadRequest:
+		return http.StatusBadRequest
+	case apperror.CodeNotFound:
+		return http.StatusNotFound
+	case apperror.CodeForbidden:
+		return http.StatusForbidden
+	case apperror.CodeUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/logger"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/metrics"
+)
+
+// RequestLogging генерирует request ID, кладет его в контекст запроса и заголовок ответа
+// X-Request-Id, логирует каждый запрос в JSON и записывает его в metrics
+func RequestLogging(log *zap.Logger, m *metrics.Metrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := logger.NewRequestID()
+			ctx := logger.ContextWithRequestID(r.Context(), requestID)
+			w.Header().Set("X-Request-Id", requestID)
+
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			start := time.Now()
+			next.ServeHTTP(rec, r.WithContext(ctx))
+			duration := time.Since(start)
+
+			code := strconv.Itoa(rec.status)
+			m.Observe(r.URL.Path, code, duration, int(r.ContentLength), rec.size)
+
+			log.Info("http request",
+				zap.String("request_id", requestID),
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", rec.status),
+				zap.Duration("duration", duration),
+			)
+		})
+	}
+}
+
+// statusRecorder оборачивает http.ResponseWriter, чтобы запомнить отданный статус и размер тела
+// для RequestLogging — сам http.ResponseWriter их не раскрывает
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.size += n
+	return n, err
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// NoteInfo содержит изменяемые поля заметки
+type NoteInfo struct {
+	Title    string
+	Context  string
+	Author   string
+	IsPublic bool
+}
+
+// Note представляет заметку целиком, включая служебные поля
+type Note struct {
+	ID        string
+	OwnerID   string
+	Info      NoteInfo
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UpdatePatch описывает частичное обновление заметки: заполняются только поля, перечисленные в Mask
+type UpdatePatch struct {
+	Info NoteInfo
+	Mask []string
+}
+
+// Filter описывает критерии отбора заметок в List. ViewerID — ID пользователя, от имени которого
+// выполняется запрос (пусто для анонимного вызова); List должен отдавать только заметки,
+// видимые этому пользователю (IsPublic или OwnerID == ViewerID), применяя это условие в самом
+// запросе к хранилищу, а не фильтруя уже полученную страницу.
+type Filter struct {
+	ViewerID string
+}
+
+// Page описывает курсорную пагинацию: Token — ID последней заметки с предыдущей страницы
+// (пусто для первой страницы), Size — максимальное число заметок в странице.
+type Page struct {
+	Token string
+	Size  int
+}
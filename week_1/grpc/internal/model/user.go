@@ -0,0 +1,8 @@
+package model
+
+// User представляет учетную запись, от имени которой выполняются запросы к NoteV1
+type User struct {
+	ID           string
+	Username     string
+	PasswordHash string
+}
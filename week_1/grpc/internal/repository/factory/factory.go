@@ -0,0 +1,121 @@
+// Package factory выбирает и инициализирует конкретную реализацию repository.NoteRepository
+// согласно STORAGE_DRIVER. Вынесен из пакета repository, чтобы избежать цикла импортов
+// repository <-> repository/memory (реализации ссылаются на repository.NoteRepository и repository.ErrNoteNotFound).
+package factory
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/auth"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository/memory"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository/mongo"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository/postgres"
+)
+
+// Driver перечисляет поддерживаемые значения переменной окружения STORAGE_DRIVER
+type Driver string
+
+const (
+	DriverMemory   Driver = "memory"
+	DriverMongo    Driver = "mongo"
+	DriverPostgres Driver = "postgres"
+)
+
+// envStorageDriver — имя переменной окружения, выбирающей бэкенд хранилища заметок
+const envStorageDriver = "STORAGE_DRIVER"
+
+// envMongoURI — адрес подключения к MongoDB, используется при STORAGE_DRIVER=mongo
+const envMongoURI = "MONGO_URI"
+
+// envMongoDatabase — имя базы данных MongoDB, используется при STORAGE_DRIVER=mongo
+const envMongoDatabase = "MONGO_DATABASE"
+
+// envPostgresDSN — строка подключения к PostgreSQL, используется при STORAGE_DRIVER=postgres
+const envPostgresDSN = "POSTGRES_DSN"
+
+// New выбирает и инициализирует реализацию NoteRepository согласно STORAGE_DRIVER. Значение по умолчанию
+// (переменная не задана) — in-memory, чтобы `go test ./...` не требовал внешней БД.
+func New(ctx context.Context) (repository.NoteRepository, error) {
+	switch Driver(os.Getenv(envStorageDriver)) {
+	case "", DriverMemory:
+		return memory.NewNoteRepository(), nil
+	case DriverMongo:
+		return newMongoRepository(ctx)
+	case DriverPostgres:
+		return newPostgresRepository(ctx)
+	default:
+		return nil, fmt.Errorf("unknown %s: %q", envStorageDriver, os.Getenv(envStorageDriver))
+	}
+}
+
+func newMongoRepository(ctx context.Context) (repository.NoteRepository, error) {
+	client, err := mongodriver.Connect(ctx, options.Client().ApplyURI(os.Getenv(envMongoURI)))
+	if err != nil {
+		return nil, fmt.Errorf("connect to mongo: %w", err)
+	}
+
+	return mongo.NewNoteRepository(client.Database(os.Getenv(envMongoDatabase))), nil
+}
+
+func newPostgresRepository(ctx context.Context) (repository.NoteRepository, error) {
+	pool, err := pgxpool.New(ctx, os.Getenv(envPostgresDSN))
+	if err != nil {
+		return nil, fmt.Errorf("connect to postgres: %w", err)
+	}
+
+	return postgres.NewNoteRepository(pool), nil
+}
+
+// demoUsername/demoPassword — единственная учетная запись, под которой можно авторизоваться
+// в этом учебном проекте, пока не появится реальная регистрация пользователей.
+const (
+	demoUsername = "admin"
+	demoPassword = "admin123"
+)
+
+// NewUserRepository возвращает UserRepository с единственной предзаполненной учетной записью
+// (логин admin, пароль admin123). STORAGE_DRIVER на пользователей пока не влияет: хранилище
+// учетных записей не подключено к внешним БД, так как аутентификация в этом проекте учебная.
+func NewUserRepository() (repository.UserRepository, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(demoPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash demo user password: %w", err)
+	}
+
+	return memory.NewUserRepository(model.User{
+		ID:           "1",
+		Username:     demoUsername,
+		PasswordHash: string(hash),
+	}), nil
+}
+
+// envJWTPrivateKeyPath — путь к PEM-файлу с RSA приватным ключом, которым подписываются JWT.
+// Нужен, чтобы grpc_server принимал токены, выданные gateway, так как без общего ключа
+// каждый процесс подписывал бы токены своим собственным.
+const envJWTPrivateKeyPath = "JWT_PRIVATE_KEY_PATH"
+
+// NewTokenManager создает auth.TokenManager на ключе из JWT_PRIVATE_KEY_PATH, либо, если
+// переменная не задана, на одноразовом сгенерированном ключе (удобно для запуска одного процесса,
+// но тогда grpc_server не сможет проверять токены, выданные gateway).
+func NewTokenManager() (*auth.TokenManager, error) {
+	path := os.Getenv(envJWTPrivateKeyPath)
+	if path == "" {
+		return auth.NewTokenManager()
+	}
+
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", envJWTPrivateKeyPath, err)
+	}
+
+	return auth.NewTokenManagerFromPEM(pemBytes)
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+)
+
+// noteRepository — потокобезопасная in-memory реализация repository.NoteRepository.
+// Используется по умолчанию и в тестах, так как не требует внешней БД.
+type noteRepository struct {
+	mu    sync.RWMutex
+	elems map[string]*model.Note
+}
+
+// NewNoteRepository создает пустое in-memory хранилище заметок
+func NewNoteRepository() repository.NoteRepository {
+	return &noteRepository{
+		elems: make(map[string]*model.Note),
+	}
+}
+
+func (r *noteRepository) Create(ctx context.Context, ownerID string, info model.NoteInfo) (string, error) {
+	_, span := repository.StartSpan(ctx, "memory.Create")
+	defer span.End()
+
+	now := time.Now()
+	id := uuid.NewString()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.elems[id] = &model.Note{
+		ID:        id,
+		OwnerID:   ownerID,
+		Info:      info,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return id, nil
+}
+
+func (r *noteRepository) Get(ctx context.Context, id string) (*model.Note, error) {
+	_, span := repository.StartSpan(ctx, "memory.Get")
+	defer span.End()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	note, ok := r.elems[id]
+	if !ok {
+		return nil, repository.ErrNoteNotFound
+	}
+
+	cp := *note
+	return &cp, nil
+}
+
+func (r *noteRepository) Update(ctx context.Context, id string, patch model.UpdatePatch) error {
+	_, span := repository.StartSpan(ctx, "memory.Update")
+	defer span.End()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	note, ok := r.elems[id]
+	if !ok {
+		return repository.ErrNoteNotFound
+	}
+
+	for _, path := range patch.Mask {
+		switch path {
+		case "title":
+			note.Info.Title = patch.Info.Title
+		case "context":
+			note.Info.Context = patch.Info.Context
+		case "author":
+			note.Info.Author = patch.Info.Author
+		case "is_public":
+			note.Info.IsPublic = patch.Info.IsPublic
+		}
+	}
+	note.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (r *noteRepository) Delete(ctx context.Context, id string) error {
+	_, span := repository.StartSpan(ctx, "memory.Delete")
+	defer span.End()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.elems[id]; !ok {
+		return repository.ErrNoteNotFound
+	}
+	delete(r.elems, id)
+
+	return nil
+}
+
+// List возвращает видимые filter.ViewerID заметки (IsPublic или OwnerID == filter.ViewerID)
+// с ID строго больше page.Token в лексикографическом порядке, не длиннее page.Size элементов.
+func (r *noteRepository) List(ctx context.Context, filter model.Filter, page model.Page) ([]*model.Note, error) {
+	_, span := repository.StartSpan(ctx, "memory.List")
+	defer span.End()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.elems))
+	for id, note := range r.elems {
+		if id > page.Token && (note.Info.IsPublic || note.OwnerID == filter.ViewerID) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	if page.Size > 0 && len(ids) > page.Size {
+		ids = ids[:page.Size]
+	}
+
+	notes := make([]*model.Note, 0, len(ids))
+	for _, id := range ids {
+		cp := *r.elems[id]
+		notes = append(notes, &cp)
+	}
+
+	return notes, nil
+}
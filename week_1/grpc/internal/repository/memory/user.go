@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+)
+
+// userRepository — потокобезопасная in-memory реализация repository.UserRepository, заполняемая
+// заранее известным набором пользователей при создании.
+type userRepository struct {
+	mu    sync.RWMutex
+	byLgn map[string]*model.User
+}
+
+// NewUserRepository создает in-memory хранилище пользователей, предзаполненное seed
+func NewUserRepository(seed ...model.User) repository.UserRepository {
+	byLgn := make(map[string]*model.User, len(seed))
+	for i := range seed {
+		u := seed[i]
+		byLgn[u.Username] = &u
+	}
+
+	return &userRepository{byLgn: byLgn}
+}
+
+func (r *userRepository) GetByUsername(_ context.Context, username string) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.byLgn[username]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	cp := *user
+	return &cp, nil
+}
@@ -0,0 +1,195 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+)
+
+const notesCollection = "notes"
+
+// noteDocument — bson-представление заметки в коллекции notesCollection
+type noteDocument struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OwnerID   string             `bson:"owner_id"`
+	Title     string             `bson:"title"`
+	Context   string             `bson:"context"`
+	Author    string             `bson:"author"`
+	IsPublic  bool               `bson:"is_public"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func (d *noteDocument) toModel() *model.Note {
+	return &model.Note{
+		ID:      d.ID.Hex(),
+		OwnerID: d.OwnerID,
+		Info: model.NoteInfo{
+			Title:    d.Title,
+			Context:  d.Context,
+			Author:   d.Author,
+			IsPublic: d.IsPublic,
+		},
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+// noteRepository — реализация repository.NoteRepository поверх MongoDB
+type noteRepository struct {
+	collection *mongo.Collection
+}
+
+// NewNoteRepository создает репозиторий заметок, хранящий документы в коллекции notesCollection базы db
+func NewNoteRepository(db *mongo.Database) repository.NoteRepository {
+	return &noteRepository{
+		collection: db.Collection(notesCollection),
+	}
+}
+
+func (r *noteRepository) Create(ctx context.Context, ownerID string, info model.NoteInfo) (string, error) {
+	ctx, span := repository.StartSpan(ctx, "mongo.Create")
+	defer span.End()
+
+	now := time.Now()
+
+	doc := noteDocument{
+		ID:        primitive.NewObjectID(),
+		OwnerID:   ownerID,
+		Title:     info.Title,
+		Context:   info.Context,
+		Author:    info.Author,
+		IsPublic:  info.IsPublic,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		return "", err
+	}
+
+	return doc.ID.Hex(), nil
+}
+
+func (r *noteRepository) Get(ctx context.Context, id string) (*model.Note, error) {
+	ctx, span := repository.StartSpan(ctx, "mongo.Get")
+	defer span.End()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repository.ErrNoteNotFound
+	}
+
+	var doc noteDocument
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, repository.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.toModel(), nil
+}
+
+func (r *noteRepository) Update(ctx context.Context, id string, patch model.UpdatePatch) error {
+	ctx, span := repository.StartSpan(ctx, "mongo.Update")
+	defer span.End()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repository.ErrNoteNotFound
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	for _, path := range patch.Mask {
+		switch path {
+		case "title":
+			set["title"] = patch.Info.Title
+		case "context":
+			set["context"] = patch.Info.Context
+		case "author":
+			set["author"] = patch.Info.Author
+		case "is_public":
+			set["is_public"] = patch.Info.IsPublic
+		}
+	}
+
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+func (r *noteRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := repository.StartSpan(ctx, "mongo.Delete")
+	defer span.End()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repository.ErrNoteNotFound
+	}
+
+	res, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+// List возвращает видимые filter.ViewerID заметки (IsPublic или OwnerID == filter.ViewerID)
+// с _id строго больше page.Token в порядке возрастания _id, не длиннее page.Size элементов.
+func (r *noteRepository) List(ctx context.Context, filter model.Filter, page model.Page) ([]*model.Note, error) {
+	ctx, span := repository.StartSpan(ctx, "mongo.List")
+	defer span.End()
+
+	query := bson.M{"$or": bson.A{
+		bson.M{"is_public": true},
+		bson.M{"owner_id": filter.ViewerID},
+	}}
+	if page.Token != "" {
+		objectID, err := primitive.ObjectIDFromHex(page.Token)
+		if err != nil {
+			return nil, err
+		}
+		query["_id"] = bson.M{"$gt": objectID}
+	}
+
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	if page.Size > 0 {
+		opts.SetLimit(int64(page.Size))
+	}
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	notes := make([]*model.Note, 0)
+	for cursor.Next(ctx) {
+		var doc noteDocument
+		if err = cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		notes = append(notes, doc.toModel())
+	}
+
+	return notes, cursor.Err()
+}
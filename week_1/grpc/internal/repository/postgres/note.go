@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository"
+)
+
+// noteRepository — реализация repository.NoteRepository поверх PostgreSQL.
+//
+// Ожидается таблица:
+//
+//	CREATE TABLE notes (
+//	    id          uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+//	    owner_id    text NOT NULL,
+//	    title       text NOT NULL,
+//	    context     text NOT NULL,
+//	    author      text NOT NULL,
+//	    is_public   boolean NOT NULL DEFAULT false,
+//	    created_at  timestamptz NOT NULL DEFAULT now(),
+//	    updated_at  timestamptz NOT NULL DEFAULT now()
+//	);
+type noteRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewNoteRepository создает репозиторий заметок поверх пула соединений pool
+func NewNoteRepository(pool *pgxpool.Pool) repository.NoteRepository {
+	return &noteRepository{pool: pool}
+}
+
+func (r *noteRepository) Create(ctx context.Context, ownerID string, info model.NoteInfo) (string, error) {
+	ctx, span := repository.StartSpan(ctx, "postgres.Create")
+	defer span.End()
+
+	const query = `
+		INSERT INTO notes (owner_id, title, context, author, is_public)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+
+	var id string
+	err := r.pool.QueryRow(ctx, query, ownerID, info.Title, info.Context, info.Author, info.IsPublic).Scan(&id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *noteRepository) Get(ctx context.Context, id string) (*model.Note, error) {
+	ctx, span := repository.StartSpan(ctx, "postgres.Get")
+	defer span.End()
+
+	const query = `
+		SELECT id, owner_id, title, context, author, is_public, created_at, updated_at
+		FROM notes
+		WHERE id = $1`
+
+	note := &model.Note{}
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&note.ID, &note.OwnerID, &note.Info.Title, &note.Info.Context, &note.Info.Author, &note.Info.IsPublic,
+		&note.CreatedAt, &note.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, repository.ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (r *noteRepository) Update(ctx context.Context, id string, patch model.UpdatePatch) error {
+	ctx, span := repository.StartSpan(ctx, "postgres.Update")
+	defer span.End()
+
+	set := map[string]any{}
+	for _, path := range patch.Mask {
+		switch path {
+		case "title":
+			set["title"] = patch.Info.Title
+		case "context":
+			set["context"] = patch.Info.Context
+		case "author":
+			set["author"] = patch.Info.Author
+		case "is_public":
+			set["is_public"] = patch.Info.IsPublic
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	query := "UPDATE notes SET updated_at = now()"
+	args := []any{}
+	for column, value := range set {
+		args = append(args, value)
+		query += ", " + column + " = $" + strconv.Itoa(len(args))
+	}
+	args = append(args, id)
+	query += " WHERE id = $" + strconv.Itoa(len(args))
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+func (r *noteRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := repository.StartSpan(ctx, "postgres.Delete")
+	defer span.End()
+
+	const query = `DELETE FROM notes WHERE id = $1`
+
+	tag, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+// List возвращает видимые filter.ViewerID заметки (IsPublic или OwnerID == filter.ViewerID)
+// с id строго больше page.Token в порядке возрастания id, не длиннее page.Size элементов.
+func (r *noteRepository) List(ctx context.Context, filter model.Filter, page model.Page) ([]*model.Note, error) {
+	ctx, span := repository.StartSpan(ctx, "postgres.List")
+	defer span.End()
+
+	args := []any{filter.ViewerID}
+	query := `
+		SELECT id, owner_id, title, context, author, is_public, created_at, updated_at
+		FROM notes
+		WHERE (is_public OR owner_id = $1)`
+
+	if page.Token != "" {
+		args = append(args, page.Token)
+		query += " AND id > $" + strconv.Itoa(len(args))
+	}
+
+	query += " ORDER BY id ASC"
+
+	if page.Size > 0 {
+		args = append(args, page.Size)
+		query += " LIMIT $" + strconv.Itoa(len(args))
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notes := make([]*model.Note, 0)
+	for rows.Next() {
+		note := &model.Note{}
+		if err = rows.Scan(
+			&note.ID, &note.OwnerID, &note.Info.Title, &note.Info.Context, &note.Info.Author, &note.Info.IsPublic,
+			&note.CreatedAt, &note.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, rows.Err()
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+)
+
+// ErrNoteNotFound возвращается, когда заметка с запрошенным ID отсутствует в хранилище
+var ErrNoteNotFound = errors.New("note not found")
+
+// NoteRepository абстрагирует хранилище заметок от конкретного драйвера (in-memory, Mongo, Postgres),
+// так что gRPC сервер (а через него и REST API cmd/gateway) работает с любым из них через один интерфейс.
+type NoteRepository interface {
+	// Create сохраняет новую заметку от имени ownerID и возвращает её сгенерированный ID
+	Create(ctx context.Context, ownerID string, info model.NoteInfo) (string, error)
+	// Get возвращает заметку по ID либо ErrNoteNotFound
+	Get(ctx context.Context, id string) (*model.Note, error)
+	// Update применяет частичное обновление, заполняя только поля, перечисленные в patch.Mask
+	Update(ctx context.Context, id string, patch model.UpdatePatch) error
+	// Delete удаляет заметку по ID либо возвращает ErrNoteNotFound
+	Delete(ctx context.Context, id string) error
+	// List возвращает страницу заметок, отфильтрованных по filter и постранично выбранных согласно page
+	List(ctx context.Context, filter model.Filter, page model.Page) ([]*model.Note, error)
+}
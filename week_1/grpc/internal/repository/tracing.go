@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer создает спаны для операций NoteRepository, чтобы трейс "gateway -> grpc server ->
+// repository" не обрывался на хранилище, независимо от выбранной реализации (memory/mongo/postgres)
+var tracer = otel.Tracer("github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/repository")
+
+// StartSpan начинает спан операции op (например "memory.Create") репозитория заметок
+func StartSpan(ctx context.Context, op string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, op)
+}
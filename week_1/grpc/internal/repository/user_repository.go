@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/internal/model"
+)
+
+// ErrUserNotFound возвращается, когда пользователь с запрошенным именем отсутствует в хранилище
+var ErrUserNotFound = errors.New("user not found")
+
+// UserRepository абстрагирует хранилище учетных записей от конкретного драйвера, так что
+// internal/auth может выдавать токены, не зная, откуда именно взялись пользователи.
+type UserRepository interface {
+	// GetByUsername возвращает пользователя по логину либо ErrUserNotFound
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+}
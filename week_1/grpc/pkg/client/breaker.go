@@ -0,0 +1,46 @@
+package client
+
+import (
+	"sync"
+
+	breaker "github.com/sony/gobreaker/v2"
+)
+
+// breakerGroup лениво заводит по одному circuit breaker'у на каждый эндпоинт, так что
+// зависший Update не размыкает цепь для вызовов Get.
+type breakerGroup struct {
+	settings CircuitBreakerSettings
+
+	mu       sync.Mutex
+	breakers map[string]*breaker.CircuitBreaker[any]
+}
+
+func newBreakerGroup(settings CircuitBreakerSettings) *breakerGroup {
+	return &breakerGroup{
+		settings: settings,
+		breakers: make(map[string]*breaker.CircuitBreaker[any]),
+	}
+}
+
+func (g *breakerGroup) forEndpoint(endpoint string) *breaker.CircuitBreaker[any] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if cb, ok := g.breakers[endpoint]; ok {
+		return cb
+	}
+
+	consecutiveFailures := g.settings.ConsecutiveFailures
+	cb := breaker.NewCircuitBreaker[any](breaker.Settings{
+		Name:        endpoint,
+		MaxRequests: g.settings.MaxRequests,
+		Interval:    g.settings.Interval,
+		Timeout:     g.settings.Timeout,
+		ReadyToTrip: func(counts breaker.Counts) bool {
+			return counts.ConsecutiveFailures >= consecutiveFailures
+		},
+	})
+	g.breakers[endpoint] = cb
+
+	return cb
+}
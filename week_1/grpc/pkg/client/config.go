@@ -0,0 +1,50 @@
+package client
+
+import "time"
+
+// Config настраивает устойчивость NoteClient и HTTPClient: сколько раз повторять запрос
+// и с какой задержкой, а также параметры circuit breaker'а, заводимого на каждый эндпоинт
+// отдельно. Разбивка на MaxRetries/задержки повторяет подход api.Config из Vault: MaxRetries = 0
+// полностью отключает повторы, так что клиент ведет себя как обычный desc.NoteV1Client.
+type Config struct {
+	// MaxRetries — сколько раз повторить запрос после первой неудачи. 0 отключает повторы.
+	MaxRetries int
+	// MinRetryWait — верхняя граница задержки перед первой повторной попыткой (сама задержка
+	// выбирается случайно от 0 до этой границы)
+	MinRetryWait time.Duration
+	// MaxRetryWait — верхняя граница экспоненциально растущей задержки
+	MaxRetryWait time.Duration
+	// Breaker — настройки circuit breaker'а, которые применяются к каждому эндпоинту отдельно
+	// (т.е. зависший Update не размыкает цепь для Get)
+	Breaker CircuitBreakerSettings
+}
+
+// CircuitBreakerSettings — подмножество gobreaker.Settings, которое имеет смысл настраивать
+// вызывающей стороне; Name выставляется клиентом per-эндпоинт и потому сюда не входит.
+type CircuitBreakerSettings struct {
+	// MaxRequests — сколько запросов разрешено в half-open состоянии
+	MaxRequests uint32
+	// Interval — период, за который в closed состоянии сбрасывается счетчик ошибок
+	Interval time.Duration
+	// Timeout — как долго breaker остается в open состоянии, прежде чем перейти в half-open
+	Timeout time.Duration
+	// ConsecutiveFailures — после скольких подряд неудач breaker переходит в open состояние
+	ConsecutiveFailures uint32
+}
+
+// DefaultConfig возвращает настройки, разумные для локальной разработки: 3 повтора,
+// граница случайной задержки растет экспоненциально от 100мс до 2с, breaker размыкается
+// после 5 ошибок подряд.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries:   3,
+		MinRetryWait: 100 * time.Millisecond,
+		MaxRetryWait: 2 * time.Second,
+		Breaker: CircuitBreakerSettings{
+			MaxRequests:         1,
+			Interval:            30 * time.Second,
+			Timeout:             10 * time.Second,
+			ConsecutiveFailures: 5,
+		},
+	}
+}
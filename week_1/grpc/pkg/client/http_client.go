@@ -0,0 +1,84 @@
+package client
+
+import (
+	"net/http"
+
+	breaker "github.com/sony/gobreaker/v2"
+)
+
+// HTTPClient оборачивает http.Client повторами и circuit breaker'ом на каждый эндпоинт
+// (метод + путь запроса), применяя ту же политику, что и NoteClient для gRPC.
+type HTTPClient struct {
+	raw      *http.Client
+	cfg      Config
+	breakers *breakerGroup
+}
+
+// NewHTTPClient оборачивает raw (nil означает http.DefaultClient) согласно cfg
+func NewHTTPClient(raw *http.Client, cfg Config) *HTTPClient {
+	if raw == nil {
+		raw = http.DefaultClient
+	}
+
+	return &HTTPClient{raw: raw, cfg: cfg, breakers: newBreakerGroup(cfg.Breaker)}
+}
+
+// Do выполняет req, повторяя его при сетевой ошибке или ответе 5xx/429. Тело запроса должно
+// поддерживать повторное чтение через req.GetBody (как у запросов, собранных http.NewRequest
+// с bytes.Reader/strings.Reader телом), иначе повтор после первой же неудачи не будет выполнен.
+func (c *HTTPClient) Do(req *http.Request) (*http.Response, error) {
+	endpoint := req.Method + " " + req.URL.Path
+
+	cfg := c.cfg
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// тело нельзя прочитать повторно — повторы для этого запроса отключены
+		cfg.MaxRetries = 0
+	}
+
+	return callWithResilience(req.Context(), cfg, c.breakers.forEndpoint(endpoint), isRetryableHTTPError, func() (*http.Response, error) {
+		attemptReq := req
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := c.raw.Do(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+		if isRetryableHTTPStatus(resp.StatusCode) {
+			_ = resp.Body.Close()
+			return nil, &retryableStatusError{statusCode: resp.StatusCode}
+		}
+
+		return resp, nil
+	})
+}
+
+// retryableStatusError оборачивает HTTP статус, классифицированный как временная ошибка
+// (5xx, 429), чтобы callWithResilience могла отличить его от успешного, но неудачного ответа
+type retryableStatusError struct {
+	statusCode int
+}
+
+func (e *retryableStatusError) Error() string {
+	return http.StatusText(e.statusCode)
+}
+
+// isRetryableHTTPError классифицирует ошибку Do: сетевые ошибки (нет статуса) и
+// retryableStatusError повторяем, осмысленные ответы — нет
+func isRetryableHTTPError(err error) bool {
+	if _, ok := err.(*retryableStatusError); ok {
+		return true
+	}
+	// сетевая ошибка (таймаут, отказ в соединении и т.п.) — безопасно повторить
+	return !isCircuitBreakerError(err)
+}
+
+func isCircuitBreakerError(err error) bool {
+	return err == breaker.ErrOpenState || err == breaker.ErrTooManyRequests
+}
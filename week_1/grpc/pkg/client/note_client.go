@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	desc "github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/note_v1"
+)
+
+// NoteClient — обертка над desc.NoteV1Client с тем же набором методов, но с повторами и
+// circuit breaker'ом на каждый эндпоинт, так что вызывающему коду не нужно оборачивать
+// каждый вызов самостоятельно.
+type NoteClient struct {
+	conn     *grpc.ClientConn
+	raw      desc.NoteV1Client
+	cfg      Config
+	breakers *breakerGroup
+}
+
+// NewNoteClient устанавливает соединение с target и возвращает NoteClient, настроенный согласно cfg.
+// dialOpts передаются в grpc.NewClient как есть (credentials, interceptors и т.д.)
+func NewNoteClient(target string, cfg Config, dialOpts ...grpc.DialOption) (*NoteClient, error) {
+	conn, err := grpc.NewClient(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NoteClient{
+		conn:     conn,
+		raw:      desc.NewNoteV1Client(conn),
+		cfg:      cfg,
+		breakers: newBreakerGroup(cfg.Breaker),
+	}, nil
+}
+
+// Close закрывает обернутое соединение
+func (c *NoteClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *NoteClient) Create(ctx context.Context, req *desc.CreateRequest, opts ...grpc.CallOption) (*desc.CreateResponse, error) {
+	return callWithResilience(ctx, c.cfg, c.breakers.forEndpoint("Create"), isRetryableGRPC, func() (*desc.CreateResponse, error) {
+		return c.raw.Create(ctx, req, opts...)
+	})
+}
+
+func (c *NoteClient) Get(ctx context.Context, req *desc.GetRequest, opts ...grpc.CallOption) (*desc.GetResponse, error) {
+	return callWithResilience(ctx, c.cfg, c.breakers.forEndpoint("Get"), isRetryableGRPC, func() (*desc.GetResponse, error) {
+		return c.raw.Get(ctx, req, opts...)
+	})
+}
+
+func (c *NoteClient) Update(ctx context.Context, req *desc.UpdateRequest, opts ...grpc.CallOption) (*desc.UpdateResponse, error) {
+	return callWithResilience(ctx, c.cfg, c.breakers.forEndpoint("Update"), isRetryableGRPC, func() (*desc.UpdateResponse, error) {
+		return c.raw.Update(ctx, req, opts...)
+	})
+}
+
+func (c *NoteClient) Delete(ctx context.Context, req *desc.DeleteRequest, opts ...grpc.CallOption) (*desc.DeleteResponse, error) {
+	return callWithResilience(ctx, c.cfg, c.breakers.forEndpoint("Delete"), isRetryableGRPC, func() (*desc.DeleteResponse, error) {
+		return c.raw.Delete(ctx, req, opts...)
+	})
+}
+
+// List устанавливает стрим и применяет повторы/breaker только к его открытию — сообщения,
+// уже полученные из стрима, не переигрываются.
+func (c *NoteClient) List(ctx context.Context, req *desc.ListRequest, opts ...grpc.CallOption) (desc.NoteV1_ListClient, error) {
+	return callWithResilience(ctx, c.cfg, c.breakers.forEndpoint("List"), isRetryableGRPC, func() (desc.NoteV1_ListClient, error) {
+		return c.raw.List(ctx, req, opts...)
+	})
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	breaker "github.com/sony/gobreaker/v2"
+)
+
+// callWithResilience выполняет call через circuit breaker эндпоинта cb и, если ошибка
+// классифицируется как retryable, повторяет ее до cfg.MaxRetries раз с растущей задержкой.
+// Брейкер размыкается независимо для каждого endpoint, поэтому один зависший метод NoteV1
+// не блокирует остальные.
+func callWithResilience[T any](ctx context.Context, cfg Config, cb *breaker.CircuitBreaker[any], retryable func(error) bool, call func() (T, error)) (T, error) {
+	var zero T
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		result, err := cb.Execute(func() (any, error) {
+			return call()
+		})
+		if err == nil {
+			return result.(T), nil
+		}
+		lastErr = err
+
+		if attempt >= cfg.MaxRetries || !retryable(err) {
+			return zero, lastErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(backoff(attempt, cfg)):
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	breaker "github.com/sony/gobreaker/v2"
+)
+
+// isRetryableGRPC решает, стоит ли повторять gRPC вызов, завершившийся err. Повторяем только
+// Unavailable и DeadlineExceeded — это единственные коды, где запрос мог не дойти до сервера
+// или не успеть выполниться, то есть безопасно выполнить его еще раз.
+func isRetryableGRPC(err error) bool {
+	if errors.Is(err, breaker.ErrOpenState) || errors.Is(err, breaker.ErrTooManyRequests) {
+		return false
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableHTTPStatus решает, стоит ли повторять запрос по коду ответа: 5xx и 429 обычно
+// означают временную перегрузку сервера, остальные статусы — ошибку клиента или осмысленный ответ.
+func isRetryableHTTPStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// backoff возвращает задержку перед attempt-й (считая с нуля) повторной попыткой: случайную
+// величину от 0 до границы, которая растет экспоненциально от cfg.MinRetryWait до cfg.MaxRetryWait,
+// чтобы параллельные клиенты не повторяли запросы синхронно.
+func backoff(attempt int, cfg Config) time.Duration {
+	wait := cfg.MinRetryWait << attempt
+	if wait <= 0 || wait > cfg.MaxRetryWait {
+		wait = cfg.MaxRetryWait
+	}
+
+	return time.Duration(rand.Int63n(int64(wait) + 1))
+}
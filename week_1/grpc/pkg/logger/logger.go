@@ -0,0 +1,34 @@
+// Package logger создает структурированный (JSON) логгер на zap, общий для cmd/grpc_server
+// и cmd/gateway, чтобы логи обеих точек входа выглядели одинаково и их можно было агрегировать
+// по полю request_id.
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// New создает JSON логгер уровня level (debug/info/warn/error; пусто означает info) с полем
+// service, проставленным во все записи этого логгера
+func New(serviceName, level string) (*zap.Logger, error) {
+	lvl := zapcore.InfoLevel
+	if level != "" {
+		if err := lvl.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("parse log level %q: %w", level, err)
+		}
+	}
+
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(lvl)
+	cfg.EncoderConfig.TimeKey = "timestamp"
+	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	l, err := cfg.Build()
+	if err != nil {
+		return nil, fmt.Errorf("build zap logger: %w", err)
+	}
+
+	return l.With(zap.String("service", serviceName)), nil
+}
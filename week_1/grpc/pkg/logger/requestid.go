@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type contextKey struct{}
+
+var requestIDKey = contextKey{}
+
+// NewRequestID генерирует новый ID запроса
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// ContextWithRequestID кладет requestID в контекст запроса
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext возвращает ID запроса, положенный туда middleware логирования.
+// Второе значение — false, если запрос не проходил через него.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey).(string)
+	return requestID, ok
+}
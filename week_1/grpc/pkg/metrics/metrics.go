@@ -0,0 +1,71 @@
+// Package metrics собирает счетчики и гистограммы Prometheus по количеству запросов, задержке
+// и размеру payload на каждый метод, общие для cmd/gateway и cmd/grpc_server.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics хранит коллекторы, зарегистрированные на собственном Registry, а не на
+// prometheus.DefaultRegisterer, чтобы gateway и grpc_server не делили глобальное состояние
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	requestSize     *prometheus.HistogramVec
+	responseSize    *prometheus.HistogramVec
+}
+
+// New регистрирует коллекторы для serviceName (попадает в namespace метрик) на новом Registry
+func New(serviceName string) *Metrics {
+	registry := prometheus.NewRegistry()
+	factory := promauto.With(registry)
+	labels := []string{"method", "code"}
+
+	return &Metrics{
+		registry: registry,
+		requestsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Name:      "requests_total",
+			Help:      "Total number of requests processed, labeled by method and status code.",
+		}, labels),
+		requestDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: serviceName,
+			Name:      "request_duration_seconds",
+			Help:      "Request latency in seconds, labeled by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		requestSize: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: serviceName,
+			Name:      "request_size_bytes",
+			Help:      "Request payload size in bytes, labeled by method and status code.",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 8),
+		}, labels),
+		responseSize: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: serviceName,
+			Name:      "response_size_bytes",
+			Help:      "Response payload size in bytes, labeled by method and status code.",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 8),
+		}, labels),
+	}
+}
+
+// Handler отдает коллекторы в формате, который понимает Prometheus, для монтирования на /metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Observe записывает один обработанный запрос к method, завершившийся кодом code
+func (m *Metrics) Observe(method, code string, duration time.Duration, requestSize, responseSize int) {
+	labels := prometheus.Labels{"method": method, "code": code}
+	m.requestsTotal.With(labels).Inc()
+	m.requestDuration.With(labels).Observe(duration.Seconds())
+	m.requestSize.With(labels).Observe(float64(requestSize))
+	m.responseSize.With(labels).Observe(float64(responseSize))
+}
@@ -0,0 +1,9 @@
+package note_v1
+
+import _ "embed"
+
+// SwaggerJSON — спецификация OpenAPI v2, сгенерированная protoc-gen-openapiv2 из note.proto.
+// Используется cmd/gateway, чтобы отдавать ее по /swagger.json.
+//
+//go:embed note.swagger.json
+var SwaggerJSON []byte
@@ -0,0 +1,36 @@
+// Package observability собирает логирование, метрики и трейсинг под одной Config, общей для
+// cmd/grpc_server и cmd/gateway, чтобы обе точки входа были наблюдаемы одинаково.
+package observability
+
+import "os"
+
+const (
+	// envLogLevel — уровень zap логгера (debug/info/warn/error), пусто означает info
+	envLogLevel = "LOG_LEVEL"
+	// envOTLPEndpoint — адрес OTLP collector (host:port); пусто отключает трейсинг
+	envOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// envMetricsAddr — адрес, на котором отдается /metrics; пусто отключает HTTP сервер метрик
+	envMetricsAddr = "METRICS_ADDR"
+)
+
+// Config задает параметры логирования, метрик и трейсинга
+type Config struct {
+	// ServiceName попадает в логи, метки метрик и ресурс трейса (service.name)
+	ServiceName string
+	// LogLevel — уровень zap логгера, пусто означает info
+	LogLevel string
+	// OTLPEndpoint — адрес OTLP collector для экспорта трейсов, пусто отключает трейсинг
+	OTLPEndpoint string
+	// MetricsAddr — адрес HTTP сервера с /metrics, пусто отключает его
+	MetricsAddr string
+}
+
+// ConfigFromEnv читает Config для serviceName из LOG_LEVEL, OTEL_EXPORTER_OTLP_ENDPOINT и METRICS_ADDR
+func ConfigFromEnv(serviceName string) Config {
+	return Config{
+		ServiceName:  serviceName,
+		LogLevel:     os.Getenv(envLogLevel),
+		OTLPEndpoint: os.Getenv(envOTLPEndpoint),
+		MetricsAddr:  os.Getenv(envMetricsAddr),
+	}
+}
@@ -0,0 +1,55 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/logger"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/metrics"
+	"github.com/ANkulagin/golang_microservices_course_balun/week_1/grpc/pkg/tracing"
+)
+
+// Provider — логгер и метрики, поднятые Setup, плюс функция остановки трейсинга
+type Provider struct {
+	Logger  *zap.Logger
+	Metrics *metrics.Metrics
+
+	shutdownTracing func(context.Context) error
+}
+
+// Setup поднимает логгер, метрики (и HTTP сервер для них на cfg.MetricsAddr, если задан)
+// и трейсинг (если задан cfg.OTLPEndpoint) согласно cfg
+func Setup(ctx context.Context, cfg Config) (*Provider, error) {
+	log, err := logger.New(cfg.ServiceName, cfg.LogLevel)
+	if err != nil {
+		return nil, fmt.Errorf("init logger: %w", err)
+	}
+
+	m := metrics.New(cfg.ServiceName)
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.ServiceName, cfg.OTLPEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("init tracing: %w", err)
+	}
+
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", m.Handler())
+		go func() {
+			if serveErr := http.ListenAndServe(cfg.MetricsAddr, mux); serveErr != nil {
+				log.Error("metrics server stopped", zap.Error(serveErr))
+			}
+		}()
+		log.Info("metrics listening", zap.String("addr", cfg.MetricsAddr))
+	}
+
+	return &Provider{Logger: log, Metrics: m, shutdownTracing: shutdownTracing}, nil
+}
+
+// Shutdown останавливает экспорт трейсов, дожидаясь отправки накопленных спанов
+func (p *Provider) Shutdown(ctx context.Context) error {
+	return p.shutdownTracing(ctx)
+}
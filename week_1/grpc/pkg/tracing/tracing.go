@@ -0,0 +1,44 @@
+// Package tracing включает OpenTelemetry трейсинг с экспортом спанов по OTLP/gRPC, так что
+// один трейс может охватывать "HTTP gateway -> gRPC server -> repository".
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Setup поднимает глобальный TracerProvider, экспортирующий спаны на otlpEndpoint по gRPC,
+// и регистрирует W3C trace context как глобальный propagator. Если otlpEndpoint пуст, трейсинг
+// не включается: возвращается no-op shutdown, а otel.Tracer(...) продолжает работать с
+// no-op реализацией по умолчанию.
+func Setup(ctx context.Context, serviceName, otlpEndpoint string) (func(context.Context) error, error) {
+	if otlpEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(otlpEndpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("build otel resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return tp.Shutdown, nil
+}
@@ -9,6 +9,9 @@
 Основные функции:
 - createNoteClient: Отправляет POST-запрос на сервер для создания новой заметки.
 - getNoteClient: Отправляет GET-запрос на сервер для получения заметки по её ID.
+- updateNoteClient: Отправляет PATCH-запрос на сервер для частичного обновления заметки.
+- deleteNoteClient: Отправляет DELETE-запрос на сервер для удаления заметки по её ID.
+- listNotesClient: Отправляет GET-запрос на сервер за страницей заметок с курсорной пагинацией.
 */
 
 package main
@@ -31,8 +34,14 @@ const (
 	baseUrl = "http://localhost:8081"
 	// createPostfix определяет путь для создания новой заметки на сервере
 	createPostfix = "/notes"
-	// getPostfix определяет путь для получения заметки по её ID. %d - формат для числового ID
-	getPostfix = "/notes/%d"
+	// getPostfix определяет путь для получения заметки по её ID. %s - формат строкового ID
+	getPostfix = "/notes/%s"
+	// updatePostfix определяет путь для частичного обновления заметки. %s - формат строкового ID
+	updatePostfix = "/notes/%s"
+	// deletePostfix определяет путь для удаления заметки. %s - формат строкового ID
+	deletePostfix = "/notes/%s"
+	// listPostfix определяет путь для постраничного получения списка заметок
+	listPostfix = "/notes?page_token=%s&page_size=%d"
 )
 
 // NoteInfo содержит информацию о заметке, аналогично структуре из серверного кода
@@ -45,12 +54,18 @@ type NoteInfo struct {
 
 // Note представляет заметку с уникальным ID и временными метками, аналогично серверной структуре
 type Note struct {
-	ID        int64    `json:"id"`         // Уникальный идентификатор заметки
+	ID        string   `json:"id"`         // Уникальный идентификатор заметки
 	Info      NoteInfo `json:"info"`       // Вложенная структура с информацией о заметке
 	CreatedAt string   `json:"created_at"` // Временная метка создания заметки
 	UpdatedAt string   `json:"updated_at"` // Временная метка последнего обновления заметки
 }
 
+// UpdateNoteRequest содержит новые значения полей и маску полей, которые нужно применить
+type UpdateNoteRequest struct {
+	Info       NoteInfo `json:"info"`        // Новые значения полей заметки
+	UpdateMask []string `json:"update_mask"` // Список JSON-имён полей, которые нужно применить
+}
+
 // createNoteClient создает новую заметку, отправляя POST-запрос на сервер
 // Возвращает созданную заметку и ошибку, если что-то пошло не так
 func createNoteClient() (Note, error) {
@@ -102,7 +117,7 @@ func createNoteClient() (Note, error) {
 
 // getNoteClient получает заметку по её ID, отправляя GET-запрос на сервер
 // Возвращает найденную заметку и ошибку, если что-то пошло не так
-func getNoteClient(id int64) (Note, error) {
+func getNoteClient(id string) (Note, error) {
 	// Форматируем URL с ID заметки и отправляем GET-запрос на сервер
 	resp, err := http.Get(fmt.Sprintf(baseUrl+getPostfix, id))
 	if err != nil {
@@ -139,6 +154,93 @@ func getNoteClient(id int64) (Note, error) {
 	return note, nil
 }
 
+// updateNoteClient частично обновляет заметку по её ID, отправляя PATCH-запрос на сервер
+// Обновляются только поля, перечисленные в update.UpdateMask
+func updateNoteClient(id string, update UpdateNoteRequest) error {
+	// Сериализуем запрос на обновление в JSON
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	// Формируем PATCH-запрос вручную, так как net/http не предоставляет http.Patch
+	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf(baseUrl+updatePostfix, id), bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Fatal("Failed to close body:", err)
+		}
+	}(resp.Body)
+
+	// Если сервер вернул статус, отличный от 204 (No Content), возвращаем ошибку
+	if resp.StatusCode != http.StatusNoContent {
+		return errors.Errorf("failed to update note: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// deleteNoteClient удаляет заметку по её ID, отправляя DELETE-запрос на сервер
+func deleteNoteClient(id string) error {
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf(baseUrl+deletePostfix, id), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Fatal("Failed to close body:", err)
+		}
+	}(resp.Body)
+
+	// Если сервер вернул статус, отличный от 204 (No Content), возвращаем ошибку
+	if resp.StatusCode != http.StatusNoContent {
+		return errors.Errorf("failed to delete note: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// listNotesClient получает страницу заметок с ID строго больше pageToken, отправляя GET-запрос на сервер
+// Следующая страница запрашивается с pageToken равным ID последней полученной заметки
+func listNotesClient(pageToken string, pageSize int) ([]Note, error) {
+	resp, err := http.Get(fmt.Sprintf(baseUrl+listPostfix, pageToken, pageSize))
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Fatal("Failed to close body:", err)
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to list notes: %d", resp.StatusCode)
+	}
+
+	var notes []Note
+	if err = json.NewDecoder(resp.Body).Decode(&notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
 // main является точкой входа в клиентское приложение
 // Оно создает заметку на сервере и затем пытается получить её по ID, логируя результаты
 func main() {
@@ -161,4 +263,25 @@ func main() {
 
 	// Логируем информацию о полученной заметке, выводя её в цвете
 	log.Printf(color.RedString("Note info got:\n"), color.GreenString("%#+v", note))
+
+	// Частично обновляем заголовок заметки
+	if err = updateNoteClient(note.ID, UpdateNoteRequest{
+		Info:       NoteInfo{Title: gofakeit.BeerName()},
+		UpdateMask: []string{"title"},
+	}); err != nil {
+		log.Fatal("failed to update note:", err)
+	}
+
+	// Получаем первую страницу заметок
+	notes, err := listNotesClient("", 10)
+	if err != nil {
+		log.Fatal("failed to list notes:", err)
+	}
+	log.Printf(color.RedString("Notes page:\n"), color.GreenString("%#+v", notes))
+
+	// Удаляем созданную заметку
+	if err = deleteNoteClient(note.ID); err != nil {
+		log.Fatal("failed to delete note:", err)
+	}
+	log.Println(color.RedString("Note deleted"))
 }